@@ -0,0 +1,103 @@
+package monitor
+
+import (
+	"fmt"
+
+	operatorv1 "github.com/openshift/api/operator/v1"
+)
+
+// conditionSeverity maps a condition type and its new status to the Level a
+// transition should be recorded at. It captures the convention shared by
+// the ClusterOperator, ClusterVersion, and operator.openshift.io
+// OperatorStatus watchers: Degraded=True, Available=False, and Failing=True
+// are Errors; every other condition flip is a Warning.
+func conditionSeverity(conditionType, status string) EventLevel {
+	switch {
+	case conditionType == "Degraded" && status == "True":
+		return Error
+	case conditionType == "Available" && status == "False":
+		return Error
+	case conditionType == "Failing" && status == "True":
+		return Error
+	default:
+		return Warning
+	}
+}
+
+// conditionTransitionMessage formats the "condition/<type> status/<status>
+// ... changed: <message>" message shared by every watcher in this package.
+// GetOperatorConditionStatus, and the sinks built on top of it, depend on
+// this exact shape.
+func conditionTransitionMessage(conditionType, status, reason, message string) string {
+	switch {
+	case len(reason) > 0 && len(message) > 0:
+		return fmt.Sprintf("condition/%s status/%s reason/%s changed: %s", conditionType, status, reason, message)
+	case len(message) > 0:
+		return fmt.Sprintf("condition/%s status/%s changed: %s", conditionType, status, message)
+	default:
+		return fmt.Sprintf("condition/%s status/%s changed: ", conditionType, status)
+	}
+}
+
+// conditionTransitionCondition builds the Condition to record for a single
+// observed transition, combining conditionSeverity and
+// conditionTransitionMessage.
+func conditionTransitionCondition(locator, conditionType, status, reason, message string) Condition {
+	return Condition{
+		Level:   conditionSeverity(conditionType, status),
+		Locator: locator,
+		Message: conditionTransitionMessage(conditionType, status, reason, message),
+	}
+}
+
+// diffConditions is the generic condition-diffing engine shared by the
+// ClusterOperator, ClusterVersion, and operator.openshift.io OperatorStatus
+// watchers. For every element of conditions whose conditionType also
+// matches an element of oldConditions with a different conditionStatus, it
+// invokes onChanged with the new element. skip, if non-nil, excludes
+// condition types from consideration entirely. The watchers in this package
+// all pass nil; the ClusterVersion watcher reports Upgradeable and
+// RetrievedUpdates flips here as well as through upgradeBlockerCondition.
+func diffConditions[T any](
+	oldConditions, conditions []T,
+	conditionType func(T) string,
+	conditionStatus func(T) string,
+	skip func(conditionType string) bool,
+	onChanged func(current T),
+) {
+	for _, c := range conditions {
+		t := conditionType(c)
+		if skip != nil && skip(t) {
+			continue
+		}
+		for _, previous := range oldConditions {
+			if conditionType(previous) != t {
+				continue
+			}
+			if conditionStatus(previous) != conditionStatus(c) {
+				onChanged(c)
+			}
+			break
+		}
+	}
+}
+
+// diffOperatorV1Conditions compares oldConditions against conditions and
+// returns a Condition, located at locator, for every condition type whose
+// Status changed. It uses the same diffConditions engine, message format,
+// and severity mapping as the ClusterOperator and ClusterVersion watchers
+// so operator.openshift.io OperatorStatus resources are reported on
+// consistently with them.
+func diffOperatorV1Conditions(locator string, oldConditions, conditions []operatorv1.OperatorCondition) []Condition {
+	var out []Condition
+	diffConditions(
+		oldConditions, conditions,
+		func(c operatorv1.OperatorCondition) string { return c.Type },
+		func(c operatorv1.OperatorCondition) string { return string(c.Status) },
+		nil,
+		func(c operatorv1.OperatorCondition) {
+			out = append(out, conditionTransitionCondition(locator, c.Type, string(c.Status), c.Reason, c.Message))
+		},
+	)
+	return out
+}
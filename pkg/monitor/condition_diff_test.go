@@ -0,0 +1,116 @@
+package monitor
+
+import (
+	"testing"
+
+	operatorv1 "github.com/openshift/api/operator/v1"
+)
+
+func TestConditionSeverity(t *testing.T) {
+	tests := []struct {
+		conditionType string
+		status        string
+		want          EventLevel
+	}{
+		{"Degraded", "True", Error},
+		{"Degraded", "False", Warning},
+		{"Available", "False", Error},
+		{"Available", "True", Warning},
+		{"Failing", "True", Error},
+		{"Upgradeable", "False", Warning},
+	}
+	for _, test := range tests {
+		if got := conditionSeverity(test.conditionType, test.status); got != test.want {
+			t.Errorf("conditionSeverity(%q, %q) = %v, want %v", test.conditionType, test.status, got, test.want)
+		}
+	}
+}
+
+func TestConditionTransitionMessage(t *testing.T) {
+	tests := []struct {
+		name                                         string
+		conditionType, status, reason, message, want string
+	}{
+		{"reason and message", "Degraded", "True", "DNSDegraded", "DNS default is degraded",
+			"condition/Degraded status/True reason/DNSDegraded changed: DNS default is degraded"},
+		{"message only", "Available", "False", "", "unreachable",
+			"condition/Available status/False changed: unreachable"},
+		{"neither", "Progressing", "True", "", "",
+			"condition/Progressing status/True changed: "},
+	}
+	for _, test := range tests {
+		if got := conditionTransitionMessage(test.conditionType, test.status, test.reason, test.message); got != test.want {
+			t.Errorf("%s: conditionTransitionMessage() = %q, want %q", test.name, got, test.want)
+		}
+	}
+}
+
+type fakeCondition struct {
+	Type   string
+	Status string
+}
+
+func TestDiffConditions(t *testing.T) {
+	oldConditions := []fakeCondition{
+		{Type: "Degraded", Status: "False"},
+		{Type: "Available", Status: "True"},
+		{Type: "Upgradeable", Status: "True"},
+	}
+	conditions := []fakeCondition{
+		{Type: "Degraded", Status: "True"},
+		{Type: "Available", Status: "True"},
+		{Type: "Upgradeable", Status: "False"},
+	}
+
+	var changed []fakeCondition
+	diffConditions(
+		oldConditions, conditions,
+		func(c fakeCondition) string { return c.Type },
+		func(c fakeCondition) string { return c.Status },
+		func(conditionType string) bool { return conditionType == "Upgradeable" },
+		func(c fakeCondition) { changed = append(changed, c) },
+	)
+
+	if len(changed) != 1 || changed[0].Type != "Degraded" {
+		t.Fatalf("changed = %+v, want only the Degraded transition (Upgradeable skipped)", changed)
+	}
+}
+
+func TestDiffConditionsNoPreviousCondition(t *testing.T) {
+	conditions := []fakeCondition{{Type: "Degraded", Status: "True"}}
+
+	var changed []fakeCondition
+	diffConditions(
+		nil, conditions,
+		func(c fakeCondition) string { return c.Type },
+		func(c fakeCondition) string { return c.Status },
+		nil,
+		func(c fakeCondition) { changed = append(changed, c) },
+	)
+
+	if len(changed) != 0 {
+		t.Errorf("changed = %+v, want none since there's no previous condition to diff against", changed)
+	}
+}
+
+func TestDiffOperatorV1Conditions(t *testing.T) {
+	oldConditions := []operatorv1.OperatorCondition{
+		{Type: "Available", Status: operatorv1.ConditionTrue},
+	}
+	conditions := []operatorv1.OperatorCondition{
+		{Type: "Available", Status: operatorv1.ConditionFalse, Reason: "Unreachable", Message: "storage backend unreachable"},
+	}
+
+	got := diffOperatorV1Conditions("operator/Storage/cluster", oldConditions, conditions)
+	if len(got) != 1 {
+		t.Fatalf("len(got) = %d, want 1", len(got))
+	}
+	c := got[0]
+	if c.Level != Error || c.Locator != "operator/Storage/cluster" {
+		t.Errorf("c = %+v", c)
+	}
+	want := "condition/Available status/False reason/Unreachable changed: storage backend unreachable"
+	if c.Message != want {
+		t.Errorf("c.Message = %q, want %q", c.Message, want)
+	}
+}
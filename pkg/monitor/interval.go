@@ -0,0 +1,159 @@
+package monitor
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	configv1 "github.com/openshift/api/config/v1"
+)
+
+// Interval represents a contiguous period of time during which a
+// ClusterOperator reported a single noteworthy condition, such as
+// Degraded=True. Unlike the point-in-time Conditions the monitor already
+// produces, Intervals let test authors reason about how long an operator
+// was unhealthy and how often it flapped, rather than just when it changed.
+type Interval struct {
+	Locator   string
+	Condition configv1.ClusterStatusConditionType
+	From      time.Time
+	To        time.Time
+	Level     EventLevel
+}
+
+// Duration returns how long the interval lasted.
+func (i Interval) Duration() time.Duration {
+	return i.To.Sub(i.From)
+}
+
+// degradedConditions are the ClusterOperator condition type/status pairs
+// that degradedWindowTracker treats as the start of a degraded window.
+var degradedConditions = map[configv1.ClusterStatusConditionType]configv1.ConditionStatus{
+	configv1.OperatorDegraded:    configv1.ConditionTrue,
+	configv1.OperatorAvailable:   configv1.ConditionFalse,
+	configv1.OperatorProgressing: configv1.ConditionTrue,
+}
+
+// degradedWindowTracker accumulates Intervals for the degradedConditions
+// observed against each ClusterOperator locator, closing a window the
+// moment the condition flips back to its healthy status.
+type degradedWindowTracker struct {
+	lock sync.Mutex
+	open map[string]map[configv1.ClusterStatusConditionType]time.Time
+	all  []Interval
+}
+
+func newDegradedWindowTracker() *degradedWindowTracker {
+	return &degradedWindowTracker{
+		open: make(map[string]map[configv1.ClusterStatusConditionType]time.Time),
+	}
+}
+
+// Observe records a transition of condition c on the ClusterOperator at
+// locator, observed at `at`. If the transition closes a previously open
+// window it returns the resulting Interval and a human-readable summary
+// Condition for it; otherwise it returns nil, nil.
+func (t *degradedWindowTracker) Observe(locator string, c *configv1.ClusterOperatorStatusCondition, at time.Time) (*Interval, *Condition) {
+	badStatus, tracked := degradedConditions[c.Type]
+	if !tracked {
+		return nil, nil
+	}
+
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	windows, ok := t.open[locator]
+	if !ok {
+		windows = make(map[configv1.ClusterStatusConditionType]time.Time)
+		t.open[locator] = windows
+	}
+
+	if c.Status == badStatus {
+		if _, open := windows[c.Type]; !open {
+			windows[c.Type] = at
+		}
+		return nil, nil
+	}
+
+	from, open := windows[c.Type]
+	if !open {
+		return nil, nil
+	}
+	delete(windows, c.Type)
+
+	interval := Interval{
+		Locator:   locator,
+		Condition: c.Type,
+		From:      from,
+		To:        at,
+		Level:     Warning,
+	}
+	t.all = append(t.all, interval)
+	return &interval, &Condition{
+		Level:   Info,
+		Locator: locator,
+		Message: fmt.Sprintf("%s was %s for %s", locator, c.Type, interval.Duration().Round(time.Second)),
+	}
+}
+
+// Intervals returns every closed Interval observed so far.
+func (t *degradedWindowTracker) Intervals() []Interval {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+	out := make([]Interval, len(t.all))
+	copy(out, t.all)
+	return out
+}
+
+// Summaries returns one Condition per locator/condition pair, summarizing
+// the total duration and number of closed windows observed for it. Any
+// window still open when Summaries is called -- e.g. a run that ends while
+// an operator is still degraded, arguably the most important case for this
+// kind of shutdown reporting -- is treated as closing now, so it isn't
+// silently dropped. It is intended to be recorded once, at shutdown, e.g.
+// "clusteroperator/dns was Degraded for 4m32s across 2 window(s)".
+func (t *degradedWindowTracker) Summaries() []Condition {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	now := time.Now()
+	intervals := make([]Interval, 0, len(t.all)+len(t.open))
+	intervals = append(intervals, t.all...)
+	for locator, windows := range t.open {
+		for conditionType, from := range windows {
+			intervals = append(intervals, Interval{
+				Locator:   locator,
+				Condition: conditionType,
+				From:      from,
+				To:        now,
+				Level:     Warning,
+			})
+		}
+	}
+
+	type key struct {
+		locator   string
+		condition configv1.ClusterStatusConditionType
+	}
+	var order []key
+	totals := make(map[key]time.Duration)
+	counts := make(map[key]int)
+	for _, iv := range intervals {
+		k := key{locator: iv.Locator, condition: iv.Condition}
+		if _, seen := totals[k]; !seen {
+			order = append(order, k)
+		}
+		totals[k] += iv.Duration()
+		counts[k]++
+	}
+
+	var out []Condition
+	for _, k := range order {
+		out = append(out, Condition{
+			Level:   Info,
+			Locator: k.locator,
+			Message: fmt.Sprintf("%s was %s for %s across %d window(s)", k.locator, k.condition, totals[k].Round(time.Second), counts[k]),
+		})
+	}
+	return out
+}
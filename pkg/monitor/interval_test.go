@@ -0,0 +1,91 @@
+package monitor
+
+import (
+	"testing"
+	"time"
+
+	configv1 "github.com/openshift/api/config/v1"
+)
+
+func TestDegradedWindowTrackerObserveOpensAndClosesWindow(t *testing.T) {
+	tracker := newDegradedWindowTracker()
+	start := time.Now()
+
+	if iv, summary := tracker.Observe("clusteroperator/dns", &configv1.ClusterOperatorStatusCondition{
+		Type: configv1.OperatorDegraded, Status: configv1.ConditionTrue,
+	}, start); iv != nil || summary != nil {
+		t.Fatalf("opening transition returned (%v, %v), want (nil, nil)", iv, summary)
+	}
+
+	end := start.Add(5 * time.Minute)
+	iv, summary := tracker.Observe("clusteroperator/dns", &configv1.ClusterOperatorStatusCondition{
+		Type: configv1.OperatorDegraded, Status: configv1.ConditionFalse,
+	}, end)
+	if iv == nil || summary == nil {
+		t.Fatalf("closing transition returned (%v, %v), want both non-nil", iv, summary)
+	}
+	if iv.Locator != "clusteroperator/dns" || iv.Condition != configv1.OperatorDegraded {
+		t.Errorf("interval = %+v", iv)
+	}
+	if iv.From != start || iv.To != end {
+		t.Errorf("interval From/To = %v/%v, want %v/%v", iv.From, iv.To, start, end)
+	}
+	if summary.Level != Info {
+		t.Errorf("summary.Level = %v, want Info", summary.Level)
+	}
+}
+
+func TestDegradedWindowTrackerIgnoresUntrackedConditions(t *testing.T) {
+	tracker := newDegradedWindowTracker()
+	iv, summary := tracker.Observe("clusteroperator/dns", &configv1.ClusterOperatorStatusCondition{
+		Type: configv1.OperatorUpgradeable, Status: configv1.ConditionFalse,
+	}, time.Now())
+	if iv != nil || summary != nil {
+		t.Errorf("Observe on untracked condition = (%v, %v), want (nil, nil)", iv, summary)
+	}
+}
+
+func TestDegradedWindowTrackerIntervalsAndSummaries(t *testing.T) {
+	tracker := newDegradedWindowTracker()
+	start := time.Now()
+
+	tracker.Observe("clusteroperator/dns", &configv1.ClusterOperatorStatusCondition{Type: configv1.OperatorDegraded, Status: configv1.ConditionTrue}, start)
+	tracker.Observe("clusteroperator/dns", &configv1.ClusterOperatorStatusCondition{Type: configv1.OperatorDegraded, Status: configv1.ConditionFalse}, start.Add(time.Minute))
+	tracker.Observe("clusteroperator/dns", &configv1.ClusterOperatorStatusCondition{Type: configv1.OperatorDegraded, Status: configv1.ConditionTrue}, start.Add(2*time.Minute))
+	tracker.Observe("clusteroperator/dns", &configv1.ClusterOperatorStatusCondition{Type: configv1.OperatorDegraded, Status: configv1.ConditionFalse}, start.Add(3*time.Minute))
+
+	if got := len(tracker.Intervals()); got != 2 {
+		t.Fatalf("len(Intervals()) = %d, want 2", got)
+	}
+
+	summaries := tracker.Summaries()
+	if len(summaries) != 1 {
+		t.Fatalf("len(Summaries()) = %d, want 1", len(summaries))
+	}
+	if summaries[0].Locator != "clusteroperator/dns" {
+		t.Errorf("summaries[0].Locator = %q", summaries[0].Locator)
+	}
+}
+
+func TestDegradedWindowTrackerSummariesClosesOpenWindow(t *testing.T) {
+	tracker := newDegradedWindowTracker()
+	start := time.Now()
+
+	tracker.Observe("clusteroperator/dns", &configv1.ClusterOperatorStatusCondition{Type: configv1.OperatorDegraded, Status: configv1.ConditionTrue}, start)
+
+	// the window is still open -- there has been no closing transition --
+	// but Summaries must still report it rather than silently dropping it.
+	summaries := tracker.Summaries()
+	if len(summaries) != 1 {
+		t.Fatalf("len(Summaries()) = %d, want 1", len(summaries))
+	}
+	if summaries[0].Locator != "clusteroperator/dns" {
+		t.Errorf("summaries[0].Locator = %q", summaries[0].Locator)
+	}
+
+	// closing the open window for reporting must not close it for real:
+	// Intervals(), which only reflects actually-closed windows, is unaffected.
+	if got := len(tracker.Intervals()); got != 0 {
+		t.Errorf("len(Intervals()) = %d, want 0 since the window never actually closed", got)
+	}
+}
@@ -47,7 +47,16 @@ func GetOperatorConditionStatus(message string) (string, bool, string) {
 	return conditions[1], status, messages[1]
 }
 
-func startClusterOperatorMonitoring(ctx context.Context, m Recorder, client configclientset.Interface) {
+// startClusterOperatorMonitoring watches ClusterOperator and ClusterVersion
+// and records a Condition for every condition-flip, version change, and
+// upgrade-blocker transition it observes. sinks, if any, are registered on m
+// so they receive the live Condition stream (e.g. JSONSink, JUnitSink) and
+// are given a chance to flush any final output once ctx is done.
+func startClusterOperatorMonitoring(ctx context.Context, m Recorder, client configclientset.Interface, metrics *OperatorConditionMetrics, sinks ...Sink) {
+	for _, s := range sinks {
+		m.AddSink(s)
+	}
+
 	coInformer := cache.NewSharedIndexInformer(
 		NewErrorRecordingListWatcher(m, &cache.ListWatch{
 			ListFunc: func(options metav1.ListOptions) (runtime.Object, error) {
@@ -62,45 +71,25 @@ func startClusterOperatorMonitoring(ctx context.Context, m Recorder, client conf
 		nil,
 	)
 
+	degradedTracker := newDegradedWindowTracker()
+
 	coChangeFns := []func(co, oldCO *configv1.ClusterOperator) []Condition{
 		func(co, oldCO *configv1.ClusterOperator) []Condition {
 			var conditions []Condition
-			for i := range co.Status.Conditions {
-				c := &co.Status.Conditions[i]
-				previous := findOperatorStatusCondition(oldCO.Status.Conditions, c.Type)
-				if previous == nil {
-					continue
-				}
-				if c.Status != previous.Status {
-					var msg string
-					switch {
-					case len(c.Reason) > 0 && len(c.Message) > 0:
-						msg = fmt.Sprintf("condition/%s status/%s reason/%s changed: %s", c.Type, c.Status, c.Reason, c.Message)
-					case len(c.Message) > 0:
-						msg = fmt.Sprintf("condition/%s status/%s changed: %s", c.Type, c.Status, c.Message)
-					default:
-						msg = fmt.Sprintf("condition/%s status/%s changed: ", c.Type, c.Status)
-					}
-					level := Warning
-					if c.Type == configv1.OperatorDegraded && c.Status == configv1.ConditionTrue {
-						level = Error
-					}
-					if c.Type == configv1.OperatorAvailable && c.Status == configv1.ConditionFalse {
-						level = Error
+			diffConditions(
+				oldCO.Status.Conditions, co.Status.Conditions,
+				func(c configv1.ClusterOperatorStatusCondition) string { return string(c.Type) },
+				func(c configv1.ClusterOperatorStatusCondition) string { return string(c.Status) },
+				nil,
+				func(c configv1.ClusterOperatorStatusCondition) {
+					metrics.observeClusterOperatorCondition(co.Name, &c)
+					if iv, summary := degradedTracker.Observe(locateClusterOperator(co), &c, time.Now()); summary != nil {
+						m.RecordInterval(*iv)
+						conditions = append(conditions, *summary)
 					}
-					if c.Type == configv1.OperatorProgressing && c.Status == configv1.ConditionTrue {
-						level = Warning
-					}
-					if c.Type == configv1.ClusterStatusConditionType("Failing") && c.Status == configv1.ConditionTrue {
-						level = Error
-					}
-					conditions = append(conditions, Condition{
-						Level:   level,
-						Locator: locateClusterOperator(co),
-						Message: msg,
-					})
-				}
-			}
+					conditions = append(conditions, conditionTransitionCondition(locateClusterOperator(co), string(c.Type), string(c.Status), c.Reason, c.Message))
+				},
+			)
 			if changes := findOperatorVersionChange(oldCO.Status.Versions, co.Status.Versions); len(changes) > 0 {
 				conditions = append(conditions, Condition{
 					Level:   Info,
@@ -120,6 +109,14 @@ func startClusterOperatorMonitoring(ctx context.Context, m Recorder, client conf
 				if !ok {
 					return
 				}
+				// seed the per-condition gauges and any already-open
+				// degraded window from the initial list, since an operator
+				// may have been in this state since before the monitor
+				// started and would otherwise never update them
+				for i := range co.Status.Conditions {
+					metrics.seedClusterOperatorCondition(co.Name, &co.Status.Conditions[i])
+					degradedTracker.Observe(locateClusterOperator(co), &co.Status.Conditions[i], time.Now())
+				}
 				// filter out old pods so our monitor doesn't send a big chunk
 				// of co creations
 				if co.CreationTimestamp.Time.Before(startTime) {
@@ -163,6 +160,12 @@ func startClusterOperatorMonitoring(ctx context.Context, m Recorder, client conf
 
 	go coInformer.Run(ctx.Done())
 
+	go func() {
+		<-ctx.Done()
+		m.Record(degradedTracker.Summaries()...)
+		flushSinksOnShutdown(sinks)
+	}()
+
 	cvInformer := cache.NewSharedIndexInformer(
 		NewErrorRecordingListWatcher(m, &cache.ListWatch{
 			ListFunc: func(options metav1.ListOptions) (runtime.Object, error) {
@@ -210,37 +213,41 @@ func startClusterOperatorMonitoring(ctx context.Context, m Recorder, client conf
 			}
 			return conditions
 		},
+		// NOTE: this used to format its Message as "changed %s to %s: %s: %s"
+		// (condition, status, reason, message), distinct from the
+		// ClusterOperator wording. Reusing conditionTransitionCondition here
+		// switches ClusterVersion to the same "condition/%s status/%s
+		// reason/%s changed: %s" format CO already uses, which is what makes
+		// these messages parseable by GetOperatorConditionStatus and the
+		// JSON sink -- but it is a wire-format change for any existing
+		// consumer that matched on the old wording.
 		func(cv, oldCV *configv1.ClusterVersion) []Condition {
 			var conditions []Condition
-			for i := range cv.Status.Conditions {
-				s := &cv.Status.Conditions[i]
-				previous := findOperatorStatusCondition(oldCV.Status.Conditions, s.Type)
-				if previous == nil {
-					continue
-				}
-				if s.Status != previous.Status {
-					var msg string
-					switch {
-					case len(s.Reason) > 0 && len(s.Message) > 0:
-						msg = fmt.Sprintf("changed %s to %s: %s: %s", s.Type, s.Status, s.Reason, s.Message)
-					case len(s.Message) > 0:
-						msg = fmt.Sprintf("changed %s to %s: %s", s.Type, s.Status, s.Message)
-					default:
-						msg = fmt.Sprintf("changed %s to %s", s.Type, s.Status)
-					}
-					level := Warning
-					if s.Type == configv1.OperatorDegraded && s.Status == configv1.ConditionTrue {
-						level = Error
-					}
-					if s.Type == configv1.ClusterStatusConditionType("Failing") && s.Status == configv1.ConditionTrue {
-						level = Error
-					}
-					conditions = append(conditions, Condition{
-						Level:   level,
-						Locator: locateClusterVersion(cv),
-						Message: msg,
-					})
-				}
+			diffConditions(
+				oldCV.Status.Conditions, cv.Status.Conditions,
+				func(c configv1.ClusterOperatorStatusCondition) string { return string(c.Type) },
+				func(c configv1.ClusterOperatorStatusCondition) string { return string(c.Status) },
+				// Every condition flip is reported here, including
+				// Upgradeable/RetrievedUpdates: upgradeBlockerCondition below
+				// additionally calls out the subset of their transitions that
+				// actually block an upgrade, at its own dedicated locator and
+				// Error severity -- a narrower, more specific signal layered
+				// on top of this one, not a replacement for it.
+				nil,
+				func(s configv1.ClusterOperatorStatusCondition) {
+					metrics.observeClusterVersionCondition(&s)
+					conditions = append(conditions, conditionTransitionCondition(locateClusterVersion(cv), string(s.Type), string(s.Status), s.Reason, s.Message))
+				},
+			)
+			return conditions
+		},
+		func(cv, oldCV *configv1.ClusterVersion) []Condition {
+			var conditions []Condition
+			if c := upgradeBlockerCondition(cv, oldCV, configv1.OperatorUpgradeable, locateClusterVersionUpgradeable(cv)); c != nil {
+				conditions = append(conditions, *c)
+			}
+			if c := upgradeBlockerCondition(cv, oldCV, configv1.RetrievedUpdates, locateClusterVersionUpgradeable(cv)); c != nil {
+				conditions = append(conditions, *c)
 			}
 			return conditions
 		},
@@ -253,6 +260,12 @@ func startClusterOperatorMonitoring(ctx context.Context, m Recorder, client conf
 				if !ok {
 					return
 				}
+				// seed the per-condition gauges from the initial list, since
+				// the ClusterVersion may have been in this state since
+				// before the monitor started
+				for i := range cv.Status.Conditions {
+					metrics.seedClusterVersionCondition(&cv.Status.Conditions[i])
+				}
 				// filter out old pods so our monitor doesn't send a big chunk
 				// of co creations
 				if cv.CreationTimestamp.Time.Before(startTime) {
@@ -296,6 +309,7 @@ func startClusterOperatorMonitoring(ctx context.Context, m Recorder, client conf
 
 	m.AddSampler(func(now time.Time) []*Condition {
 		var conditions []*Condition
+		updating := false
 		for _, obj := range cvInformer.GetStore().List() {
 			cv, ok := obj.(*configv1.ClusterVersion)
 			if !ok {
@@ -303,6 +317,7 @@ func startClusterOperatorMonitoring(ctx context.Context, m Recorder, client conf
 			}
 			if len(cv.Status.History) > 0 {
 				if cv.Status.History[0].State != configv1.CompletedUpdate {
+					updating = true
 					conditions = append(conditions, &Condition{
 						Level:   Warning,
 						Locator: locateClusterVersion(cv),
@@ -311,6 +326,7 @@ func startClusterOperatorMonitoring(ctx context.Context, m Recorder, client conf
 				}
 			}
 		}
+		metrics.setClusterUpdating(updating)
 		return conditions
 	})
 
@@ -332,6 +348,57 @@ func locateClusterVersion(cv *configv1.ClusterVersion) string {
 	return fmt.Sprintf("clusterversion/%s", cv.Name)
 }
 
+func locateClusterVersionUpgradeable(cv *configv1.ClusterVersion) string {
+	return fmt.Sprintf("clusterversion/%s/upgradeable", cv.Name)
+}
+
+// upgradeBlockerCondition looks for a transition of conditionType between
+// oldCV and cv that indicates the cluster can no longer move to a new
+// version, and returns the Condition to record for it, or nil if this
+// transition isn't an upgrade blocker worth calling out separately from the
+// generic condition-flip handling above.
+func upgradeBlockerCondition(cv, oldCV *configv1.ClusterVersion, conditionType configv1.ClusterStatusConditionType, locator string) *Condition {
+	current := findOperatorStatusCondition(cv.Status.Conditions, conditionType)
+	if current == nil {
+		return nil
+	}
+	previous := findOperatorStatusCondition(oldCV.Status.Conditions, conditionType)
+	if previous == nil || current.Status == previous.Status {
+		return nil
+	}
+
+	switch conditionType {
+	case configv1.OperatorUpgradeable:
+		if current.Status != configv1.ConditionFalse {
+			return nil
+		}
+	case configv1.RetrievedUpdates:
+		if current.Status != configv1.ConditionFalse || !isPreconditionBlockerReason(current.Reason) {
+			return nil
+		}
+	default:
+		return nil
+	}
+
+	return &Condition{
+		Level:   Error,
+		Locator: locator,
+		Message: fmt.Sprintf("condition/%s status/%s reason/%s changed: %s", current.Type, current.Status, current.Reason, current.Message),
+	}
+}
+
+// isPreconditionBlockerReason reports whether reason identifies a known
+// update precondition or override failure, as opposed to an unrelated cause
+// of RetrievedUpdates flipping to False (e.g. a transient upstream fetch
+// error).
+func isPreconditionBlockerReason(reason string) bool {
+	switch reason {
+	case "PreconditionCheckFailed", "ClusterVersionOverridesSet":
+		return true
+	}
+	return strings.Contains(reason, "Precondition")
+}
+
 func findOperatorVersionChange(old, new []configv1.OperandVersion) []string {
 	var changed []string
 	for i := 0; i < len(new); i++ {
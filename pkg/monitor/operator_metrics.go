@@ -0,0 +1,113 @@
+package monitor
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+
+	configv1 "github.com/openshift/api/config/v1"
+)
+
+// OperatorConditionMetrics is a prometheus.Collector that tracks the
+// ClusterOperator and ClusterVersion condition transitions observed by
+// startClusterOperatorMonitoring. It is registered by the caller alongside
+// whatever else is exposed on the process' metrics endpoint.
+type OperatorConditionMetrics struct {
+	transitions *prometheus.CounterVec
+	coState     *prometheus.GaugeVec
+	cvState     *prometheus.GaugeVec
+	cvUpdating  prometheus.Gauge
+}
+
+// NewOperatorConditionMetrics constructs an unregistered
+// OperatorConditionMetrics collector.
+func NewOperatorConditionMetrics() *OperatorConditionMetrics {
+	return &OperatorConditionMetrics{
+		transitions: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "monitor_cluster_operator_condition_transitions_total",
+			Help: "Number of observed ClusterOperator or ClusterVersion condition status transitions, labeled by the reporting operator, condition type, new status, and reason.",
+		}, []string{"operator", "type", "status", "reason"}),
+		coState: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Name: "monitor_cluster_operator_condition_state",
+			Help: "Current status (1 for True, 0 otherwise) of a ClusterOperator condition, labeled by operator and condition type.",
+		}, []string{"operator", "type"}),
+		cvState: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Name: "monitor_cluster_version_condition_state",
+			Help: "Current status (1 for True, 0 otherwise) of a ClusterVersion condition, labeled by condition type.",
+		}, []string{"type"}),
+		cvUpdating: prometheus.NewGauge(prometheus.GaugeOpts{
+			Name: "monitor_cluster_is_updating",
+			Help: "1 if the cluster version is currently converging on an update that has not completed, 0 otherwise.",
+		}),
+	}
+}
+
+// Describe implements prometheus.Collector.
+func (m *OperatorConditionMetrics) Describe(ch chan<- *prometheus.Desc) {
+	m.transitions.Describe(ch)
+	m.coState.Describe(ch)
+	m.cvState.Describe(ch)
+	m.cvUpdating.Describe(ch)
+}
+
+// Collect implements prometheus.Collector.
+func (m *OperatorConditionMetrics) Collect(ch chan<- prometheus.Metric) {
+	m.transitions.Collect(ch)
+	m.coState.Collect(ch)
+	m.cvState.Collect(ch)
+	m.cvUpdating.Collect(ch)
+}
+
+func (m *OperatorConditionMetrics) observeClusterOperatorCondition(operator string, c *configv1.ClusterOperatorStatusCondition) {
+	if m == nil {
+		return
+	}
+	m.transitions.WithLabelValues(operator, string(c.Type), string(c.Status), c.Reason).Inc()
+	m.coState.WithLabelValues(operator, string(c.Type)).Set(conditionStatusValue(c.Status))
+}
+
+func (m *OperatorConditionMetrics) observeClusterVersionCondition(c *configv1.ClusterOperatorStatusCondition) {
+	if m == nil {
+		return
+	}
+	m.transitions.WithLabelValues("version", string(c.Type), string(c.Status), c.Reason).Inc()
+	m.cvState.WithLabelValues(string(c.Type)).Set(conditionStatusValue(c.Status))
+}
+
+// seedClusterOperatorCondition sets coState to c's current status without
+// touching the transitions counter. It's called from the informer's
+// initial Add for each ClusterOperator, since an operator may have already
+// been in this state since before the monitor started and would otherwise
+// never update the gauge until its next transition, which may never come
+// during a short e2e run.
+func (m *OperatorConditionMetrics) seedClusterOperatorCondition(operator string, c *configv1.ClusterOperatorStatusCondition) {
+	if m == nil {
+		return
+	}
+	m.coState.WithLabelValues(operator, string(c.Type)).Set(conditionStatusValue(c.Status))
+}
+
+// seedClusterVersionCondition is the ClusterVersion analogue of
+// seedClusterOperatorCondition.
+func (m *OperatorConditionMetrics) seedClusterVersionCondition(c *configv1.ClusterOperatorStatusCondition) {
+	if m == nil {
+		return
+	}
+	m.cvState.WithLabelValues(string(c.Type)).Set(conditionStatusValue(c.Status))
+}
+
+func (m *OperatorConditionMetrics) setClusterUpdating(updating bool) {
+	if m == nil {
+		return
+	}
+	if updating {
+		m.cvUpdating.Set(1)
+	} else {
+		m.cvUpdating.Set(0)
+	}
+}
+
+func conditionStatusValue(status configv1.ConditionStatus) float64 {
+	if status == configv1.ConditionTrue {
+		return 1
+	}
+	return 0
+}
@@ -0,0 +1,73 @@
+package monitor
+
+import (
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+
+	configv1 "github.com/openshift/api/config/v1"
+)
+
+func TestConditionStatusValue(t *testing.T) {
+	tests := []struct {
+		status configv1.ConditionStatus
+		want   float64
+	}{
+		{configv1.ConditionTrue, 1},
+		{configv1.ConditionFalse, 0},
+		{configv1.ConditionUnknown, 0},
+	}
+	for _, test := range tests {
+		if got := conditionStatusValue(test.status); got != test.want {
+			t.Errorf("conditionStatusValue(%s) = %v, want %v", test.status, got, test.want)
+		}
+	}
+}
+
+func TestSeedClusterOperatorConditionDoesNotIncrementTransitions(t *testing.T) {
+	m := NewOperatorConditionMetrics()
+	c := &configv1.ClusterOperatorStatusCondition{Type: configv1.OperatorDegraded, Status: configv1.ConditionTrue, Reason: "Because"}
+
+	m.seedClusterOperatorCondition("dns", c)
+
+	if got := testutil.ToFloat64(m.coState.WithLabelValues("dns", "Degraded")); got != 1 {
+		t.Errorf("coState = %v, want 1", got)
+	}
+	if got := testutil.ToFloat64(m.transitions.WithLabelValues("dns", "Degraded", "True", "Because")); got != 0 {
+		t.Errorf("transitions = %v, want 0 since seeding must not count as a transition", got)
+	}
+}
+
+func TestSeedClusterVersionCondition(t *testing.T) {
+	m := NewOperatorConditionMetrics()
+	c := &configv1.ClusterOperatorStatusCondition{Type: configv1.OperatorUpgradeable, Status: configv1.ConditionFalse}
+
+	m.seedClusterVersionCondition(c)
+
+	if got := testutil.ToFloat64(m.cvState.WithLabelValues("Upgradeable")); got != 0 {
+		t.Errorf("cvState = %v, want 0", got)
+	}
+}
+
+func TestObserveClusterOperatorConditionIncrementsTransitions(t *testing.T) {
+	m := NewOperatorConditionMetrics()
+	c := &configv1.ClusterOperatorStatusCondition{Type: configv1.OperatorAvailable, Status: configv1.ConditionFalse, Reason: "Unreachable"}
+
+	m.observeClusterOperatorCondition("dns", c)
+
+	if got := testutil.ToFloat64(m.transitions.WithLabelValues("dns", "Available", "False", "Unreachable")); got != 1 {
+		t.Errorf("transitions = %v, want 1", got)
+	}
+	if got := testutil.ToFloat64(m.coState.WithLabelValues("dns", "Available")); got != 0 {
+		t.Errorf("coState = %v, want 0", got)
+	}
+}
+
+func TestNilOperatorConditionMetricsIsNoop(t *testing.T) {
+	var m *OperatorConditionMetrics
+	m.observeClusterOperatorCondition("dns", &configv1.ClusterOperatorStatusCondition{})
+	m.observeClusterVersionCondition(&configv1.ClusterOperatorStatusCondition{})
+	m.seedClusterOperatorCondition("dns", &configv1.ClusterOperatorStatusCondition{})
+	m.seedClusterVersionCondition(&configv1.ClusterOperatorStatusCondition{})
+	m.setClusterUpdating(true)
+}
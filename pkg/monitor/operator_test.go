@@ -0,0 +1,97 @@
+package monitor
+
+import (
+	"testing"
+
+	configv1 "github.com/openshift/api/config/v1"
+)
+
+func TestIsPreconditionBlockerReason(t *testing.T) {
+	tests := []struct {
+		reason string
+		want   bool
+	}{
+		{"PreconditionCheckFailed", true},
+		{"ClusterVersionOverridesSet", true},
+		{"SomePreconditionFailure", true},
+		{"RemoteFailed", false},
+		{"", false},
+	}
+	for _, test := range tests {
+		if got := isPreconditionBlockerReason(test.reason); got != test.want {
+			t.Errorf("isPreconditionBlockerReason(%q) = %v, want %v", test.reason, got, test.want)
+		}
+	}
+}
+
+func clusterVersionWithCondition(conditionType configv1.ClusterStatusConditionType, status configv1.ConditionStatus, reason, message string) *configv1.ClusterVersion {
+	cv := &configv1.ClusterVersion{}
+	if conditionType == "" {
+		return cv
+	}
+	cv.Status.Conditions = []configv1.ClusterOperatorStatusCondition{
+		{Type: conditionType, Status: status, Reason: reason, Message: message},
+	}
+	return cv
+}
+
+func TestUpgradeBlockerConditionUpgradeable(t *testing.T) {
+	oldCV := clusterVersionWithCondition(configv1.OperatorUpgradeable, configv1.ConditionTrue, "", "")
+	cv := clusterVersionWithCondition(configv1.OperatorUpgradeable, configv1.ConditionFalse, "AdminAckRequired", "manual intervention needed")
+
+	c := upgradeBlockerCondition(cv, oldCV, configv1.OperatorUpgradeable, "clusterversion/version/upgradeable")
+	if c == nil {
+		t.Fatalf("upgradeBlockerCondition returned nil, want a Condition")
+	}
+	if c.Level != Error || c.Locator != "clusterversion/version/upgradeable" {
+		t.Errorf("c = %+v", c)
+	}
+
+	// flipping back to True is not a blocker worth reporting here.
+	if c := upgradeBlockerCondition(oldCV, cv, configv1.OperatorUpgradeable, "clusterversion/version/upgradeable"); c != nil {
+		t.Errorf("upgradeBlockerCondition(False->True) = %+v, want nil", c)
+	}
+}
+
+func TestUpgradeBlockerConditionRetrievedUpdates(t *testing.T) {
+	oldCV := clusterVersionWithCondition(configv1.RetrievedUpdates, configv1.ConditionTrue, "", "")
+
+	blocked := clusterVersionWithCondition(configv1.RetrievedUpdates, configv1.ConditionFalse, "PreconditionCheckFailed", "blocked")
+	if c := upgradeBlockerCondition(blocked, oldCV, configv1.RetrievedUpdates, "clusterversion/version/upgradeable"); c == nil {
+		t.Errorf("upgradeBlockerCondition with precondition failure = nil, want a Condition")
+	}
+
+	notBlocked := clusterVersionWithCondition(configv1.RetrievedUpdates, configv1.ConditionFalse, "RemoteFailed", "transient fetch error")
+	if c := upgradeBlockerCondition(notBlocked, oldCV, configv1.RetrievedUpdates, "clusterversion/version/upgradeable"); c != nil {
+		t.Errorf("upgradeBlockerCondition with non-precondition reason = %+v, want nil", c)
+	}
+}
+
+func TestUpgradeBlockerConditionNoChange(t *testing.T) {
+	oldCV := clusterVersionWithCondition(configv1.OperatorUpgradeable, configv1.ConditionFalse, "Foo", "bar")
+	cv := clusterVersionWithCondition(configv1.OperatorUpgradeable, configv1.ConditionFalse, "Foo", "bar")
+
+	if c := upgradeBlockerCondition(cv, oldCV, configv1.OperatorUpgradeable, "clusterversion/version/upgradeable"); c != nil {
+		t.Errorf("upgradeBlockerCondition with unchanged status = %+v, want nil", c)
+	}
+}
+
+func TestGetOperatorConditionStatus(t *testing.T) {
+	tests := []struct {
+		message       string
+		conditionType string
+		status        bool
+		text          string
+	}{
+		{"condition/Degraded status/True reason/DNSDegraded changed: DNS default is degraded", "Degraded", true, "DNS default is degraded"},
+		{"condition/Available status/False changed: unreachable", "Available", false, "unreachable"},
+		{"not a condition message", "", false, ""},
+	}
+	for _, test := range tests {
+		conditionType, status, text := GetOperatorConditionStatus(test.message)
+		if conditionType != test.conditionType || status != test.status || text != test.text {
+			t.Errorf("GetOperatorConditionStatus(%q) = (%q, %v, %q), want (%q, %v, %q)",
+				test.message, conditionType, status, text, test.conditionType, test.status, test.text)
+		}
+	}
+}
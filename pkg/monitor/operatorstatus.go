@@ -0,0 +1,141 @@
+package monitor
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/watch"
+	"k8s.io/client-go/tools/cache"
+
+	operatorv1 "github.com/openshift/api/operator/v1"
+	operatorclientset "github.com/openshift/client-go/operator/clientset/versioned"
+)
+
+// operatorStatusSource describes how to list/watch a single
+// operator.openshift.io/v1 kind and pull its OperatorStatus conditions out
+// of whatever concrete type the clientset returns. kind is used to build
+// the "operator/<kind>/<name>" locator; name and UID come from the object's
+// metav1.Object interface, which every generated operator.openshift.io type
+// implements via its embedded ObjectMeta.
+type operatorStatusSource struct {
+	kind      string
+	expected  runtime.Object
+	listFunc  cache.ListFunc
+	watchFunc cache.WatchFunc
+	// conditions returns the OperatorStatus conditions of obj, or ok=false
+	// if obj isn't the expected type.
+	conditions func(obj interface{}) (conditions []operatorv1.OperatorCondition, ok bool)
+}
+
+// startOperatorStatusMonitoring watches the operator.openshift.io/v1
+// singleton resources that publish health only through their own
+// OperatorStatus rather than surfacing it on a ClusterOperator, and records
+// a Condition for every condition-type status transition using the same
+// diffing and severity mapping as startClusterOperatorMonitoring.
+func startOperatorStatusMonitoring(ctx context.Context, m Recorder, client operatorclientset.Interface) {
+	sources := []operatorStatusSource{
+		{
+			kind:     "Storage",
+			expected: &operatorv1.Storage{},
+			listFunc: func(options metav1.ListOptions) (runtime.Object, error) {
+				return client.OperatorV1().Storages().List(ctx, options)
+			},
+			watchFunc: func(options metav1.ListOptions) (watch.Interface, error) {
+				return client.OperatorV1().Storages().Watch(ctx, options)
+			},
+			conditions: func(obj interface{}) ([]operatorv1.OperatorCondition, bool) {
+				o, ok := obj.(*operatorv1.Storage)
+				if !ok {
+					return nil, false
+				}
+				return o.Status.Conditions, true
+			},
+		},
+		{
+			kind:     "CSISnapshotController",
+			expected: &operatorv1.CSISnapshotController{},
+			listFunc: func(options metav1.ListOptions) (runtime.Object, error) {
+				return client.OperatorV1().CSISnapshotControllers().List(ctx, options)
+			},
+			watchFunc: func(options metav1.ListOptions) (watch.Interface, error) {
+				return client.OperatorV1().CSISnapshotControllers().Watch(ctx, options)
+			},
+			conditions: func(obj interface{}) ([]operatorv1.OperatorCondition, bool) {
+				o, ok := obj.(*operatorv1.CSISnapshotController)
+				if !ok {
+					return nil, false
+				}
+				return o.Status.Conditions, true
+			},
+		},
+		{
+			kind:     "Console",
+			expected: &operatorv1.Console{},
+			listFunc: func(options metav1.ListOptions) (runtime.Object, error) {
+				return client.OperatorV1().Consoles().List(ctx, options)
+			},
+			watchFunc: func(options metav1.ListOptions) (watch.Interface, error) {
+				return client.OperatorV1().Consoles().Watch(ctx, options)
+			},
+			conditions: func(obj interface{}) ([]operatorv1.OperatorCondition, bool) {
+				o, ok := obj.(*operatorv1.Console)
+				if !ok {
+					return nil, false
+				}
+				return o.Status.Conditions, true
+			},
+		},
+	}
+
+	for _, source := range sources {
+		startOperatorStatusSourceMonitoring(ctx, m, source)
+	}
+}
+
+func startOperatorStatusSourceMonitoring(ctx context.Context, m Recorder, source operatorStatusSource) {
+	informer := cache.NewSharedIndexInformer(
+		NewErrorRecordingListWatcher(m, &cache.ListWatch{
+			ListFunc:  source.listFunc,
+			WatchFunc: source.watchFunc,
+		}),
+		source.expected,
+		time.Hour,
+		nil,
+	)
+
+	locate := func(name string) string {
+		return fmt.Sprintf("operator/%s/%s", source.kind, name)
+	}
+
+	informer.AddEventHandler(
+		cache.ResourceEventHandlerFuncs{
+			UpdateFunc: func(old, obj interface{}) {
+				newMeta, ok := obj.(metav1.Object)
+				if !ok {
+					return
+				}
+				oldMeta, ok := old.(metav1.Object)
+				if !ok {
+					return
+				}
+				if newMeta.GetUID() != oldMeta.GetUID() {
+					return
+				}
+				conditions, ok := source.conditions(obj)
+				if !ok {
+					return
+				}
+				oldConditions, ok := source.conditions(old)
+				if !ok {
+					return
+				}
+				m.Record(diffOperatorV1Conditions(locate(newMeta.GetName()), oldConditions, conditions)...)
+			},
+		},
+	)
+
+	go informer.Run(ctx.Done())
+}
@@ -0,0 +1,33 @@
+package monitor
+
+import (
+	"io"
+	"os"
+)
+
+// Sink receives every Condition recorded by a Recorder, in addition to
+// whatever in-memory storage the Recorder itself keeps. Implementations are
+// registered with Recorder.AddSink and must not block for long, since
+// Record is typically called from informer event handlers.
+type Sink interface {
+	Record(conditions ...Condition)
+}
+
+// sinkFlusher is implemented by sinks that must take a final action once
+// monitoring stops, such as JUnitSink writing out its accumulated
+// testsuite. Sinks that write as they go, like JSONSink, don't need it.
+type sinkFlusher interface {
+	Flush(w io.Writer) error
+}
+
+// flushSinksOnShutdown gives every sink in sinks that implements sinkFlusher
+// a chance to run its final action, writing to os.Stdout. Errors are not
+// actionable this late in shutdown and are dropped, matching how Sink.Record
+// implementations in this package already treat write failures.
+func flushSinksOnShutdown(sinks []Sink) {
+	for _, s := range sinks {
+		if f, ok := s.(sinkFlusher); ok {
+			_ = f.Flush(os.Stdout)
+		}
+	}
+}
@@ -0,0 +1,78 @@
+package monitor
+
+import (
+	"encoding/json"
+	"io"
+	"strings"
+	"sync"
+	"time"
+)
+
+// jsonCondition is the wire format written by JSONSink, one per line. The
+// condition/status/reason fields are parsed out of Message via
+// GetOperatorConditionStatus so downstream tooling doesn't need to
+// re-implement that parsing.
+type jsonCondition struct {
+	Timestamp time.Time  `json:"timestamp"`
+	Level     EventLevel `json:"level"`
+	Locator   string     `json:"locator"`
+	Message   string     `json:"message"`
+	Condition string     `json:"condition,omitempty"`
+	Status    bool       `json:"status"`
+	Reason    string     `json:"reason,omitempty"`
+}
+
+// JSONSink writes every recorded Condition as a line of JSON to the
+// underlying writer, suitable for ingestion by CI log pipelines that don't
+// otherwise understand the monitor's in-memory Condition stream.
+type JSONSink struct {
+	lock sync.Mutex
+	w    io.Writer
+	enc  *json.Encoder
+}
+
+// NewJSONSink returns a Sink that appends newline-delimited JSON records to w.
+func NewJSONSink(w io.Writer) *JSONSink {
+	return &JSONSink{w: w, enc: json.NewEncoder(w)}
+}
+
+// Record implements Sink.
+func (s *JSONSink) Record(conditions ...Condition) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	now := time.Now()
+	for _, c := range conditions {
+		condition, status, _ := GetOperatorConditionStatus(c.Message)
+		record := jsonCondition{
+			Timestamp: now,
+			Level:     c.Level,
+			Locator:   c.Locator,
+			Message:   c.Message,
+			Condition: condition,
+			Status:    status,
+		}
+		if _, _, reason := parseConditionReason(c.Message); len(reason) > 0 {
+			record.Reason = reason
+		}
+		// errors writing to the underlying sink (e.g. a closed file) are not
+		// actionable from here and are intentionally dropped, matching how
+		// the rest of the monitor treats best-effort observability output.
+		_ = s.enc.Encode(record)
+	}
+}
+
+// parseConditionReason extracts the reason portion from a
+// "condition/<type> status/<bool> reason/<reason> changed: <message>" style
+// message, mirroring the format produced by startClusterOperatorMonitoring.
+func parseConditionReason(message string) (conditionType string, status bool, reason string) {
+	conditionType, status, _ = GetOperatorConditionStatus(message)
+	idx := strings.Index(message, " reason/")
+	if idx < 0 {
+		return conditionType, status, ""
+	}
+	rest := message[idx+len(" reason/"):]
+	if end := strings.IndexByte(rest, ' '); end >= 0 {
+		return conditionType, status, rest[:end]
+	}
+	return conditionType, status, rest
+}
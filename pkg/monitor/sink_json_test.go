@@ -0,0 +1,72 @@
+package monitor
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+	"testing"
+)
+
+func TestJSONSinkRecordEmitsFalseStatus(t *testing.T) {
+	var buf bytes.Buffer
+	s := NewJSONSink(&buf)
+
+	s.Record(Condition{
+		Level:   Error,
+		Locator: "clusteroperator/dns",
+		Message: "condition/Available status/False reason/Unreachable changed: DNS default is unreachable",
+	})
+
+	var record jsonCondition
+	if err := json.Unmarshal(buf.Bytes(), &record); err != nil {
+		t.Fatalf("unmarshal: %v", err)
+	}
+	if record.Condition != "Available" {
+		t.Errorf("Condition = %q, want %q", record.Condition, "Available")
+	}
+	if record.Status {
+		t.Errorf("Status = true, want false")
+	}
+	if record.Reason != "Unreachable" {
+		t.Errorf("Reason = %q, want %q", record.Reason, "Unreachable")
+	}
+
+	if !strings.Contains(buf.String(), `"status":false`) {
+		t.Errorf("encoded record dropped the false status field, got: %s", buf.String())
+	}
+}
+
+func TestJSONSinkRecordUnparseableMessage(t *testing.T) {
+	var buf bytes.Buffer
+	s := NewJSONSink(&buf)
+
+	s.Record(Condition{Level: Info, Locator: "clusteroperator/dns", Message: "created"})
+
+	var record jsonCondition
+	if err := json.Unmarshal(buf.Bytes(), &record); err != nil {
+		t.Fatalf("unmarshal: %v", err)
+	}
+	if record.Condition != "" || record.Status {
+		t.Errorf("unparseable message should leave Condition empty and Status false, got %+v", record)
+	}
+}
+
+func TestParseConditionReason(t *testing.T) {
+	tests := []struct {
+		message       string
+		conditionType string
+		status        bool
+		reason        string
+	}{
+		{"condition/Degraded status/True reason/DNSDegraded changed: DNS default is degraded", "Degraded", true, "DNSDegraded"},
+		{"condition/Available status/False changed: unreachable", "Available", false, ""},
+		{"created", "", false, ""},
+	}
+	for _, test := range tests {
+		conditionType, status, reason := parseConditionReason(test.message)
+		if conditionType != test.conditionType || status != test.status || reason != test.reason {
+			t.Errorf("parseConditionReason(%q) = (%q, %v, %q), want (%q, %v, %q)",
+				test.message, conditionType, status, reason, test.conditionType, test.status, test.reason)
+		}
+	}
+}
@@ -0,0 +1,99 @@
+package monitor
+
+import (
+	"encoding/xml"
+	"fmt"
+	"io"
+	"strings"
+	"sync"
+)
+
+// junitTestSuite and junitTestCase are a minimal JUnit XML representation,
+// sufficient for CI systems that already know how to ingest e2e test
+// results to also ingest ClusterOperator/ClusterVersion degradation events.
+type junitTestSuite struct {
+	XMLName  xml.Name        `xml:"testsuite"`
+	Name     string          `xml:"name,attr"`
+	Tests    int             `xml:"tests,attr"`
+	Failures int             `xml:"failures,attr"`
+	Cases    []junitTestCase `xml:"testcase"`
+}
+
+type junitTestCase struct {
+	Name    string        `xml:"name,attr"`
+	Failure *junitFailure `xml:"failure,omitempty"`
+}
+
+type junitFailure struct {
+	Message string `xml:"message,attr"`
+	Text    string `xml:",chardata"`
+}
+
+// JUnitSink accumulates Error-level Conditions reported against
+// clusteroperator/* and clusterversion/* locators and, on Flush, writes
+// them out as a JUnit testsuite grouped one testcase per locator. This lets
+// CI ingest ClusterOperator degradation the same way it ingests test
+// failures.
+type JUnitSink struct {
+	suiteName string
+
+	lock     sync.Mutex
+	messages map[string][]string
+	order    []string
+}
+
+// NewJUnitSink returns a Sink that groups Error-level conditions by locator
+// and writes them as suiteName when Flush is called.
+func NewJUnitSink(suiteName string) *JUnitSink {
+	return &JUnitSink{
+		suiteName: suiteName,
+		messages:  make(map[string][]string),
+	}
+}
+
+// Record implements Sink.
+func (s *JUnitSink) Record(conditions ...Condition) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	for _, c := range conditions {
+		if c.Level != Error {
+			continue
+		}
+		if !strings.HasPrefix(c.Locator, "clusteroperator/") && !strings.HasPrefix(c.Locator, "clusterversion/") {
+			continue
+		}
+		if _, ok := s.messages[c.Locator]; !ok {
+			s.order = append(s.order, c.Locator)
+		}
+		s.messages[c.Locator] = append(s.messages[c.Locator], c.Message)
+	}
+}
+
+// Flush writes the accumulated testsuite to w as JUnit XML. It is intended
+// to be called once, on shutdown.
+func (s *JUnitSink) Flush(w io.Writer) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	suite := junitTestSuite{
+		Name:     s.suiteName,
+		Tests:    len(s.order),
+		Failures: len(s.order),
+	}
+	for _, locator := range s.order {
+		suite.Cases = append(suite.Cases, junitTestCase{
+			Name: locator,
+			Failure: &junitFailure{
+				Message: fmt.Sprintf("%s reported %d error condition(s)", locator, len(s.messages[locator])),
+				Text:    strings.Join(s.messages[locator], "\n"),
+			},
+		})
+	}
+
+	if _, err := io.WriteString(w, xml.Header); err != nil {
+		return err
+	}
+	enc := xml.NewEncoder(w)
+	enc.Indent("", "  ")
+	return enc.Encode(suite)
+}
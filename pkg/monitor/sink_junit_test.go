@@ -0,0 +1,71 @@
+package monitor
+
+import (
+	"bytes"
+	"encoding/xml"
+	"testing"
+)
+
+func TestJUnitSinkRecordFiltersNonErrorAndUnrecognizedLocators(t *testing.T) {
+	s := NewJUnitSink("e2e")
+
+	s.Record(
+		Condition{Level: Warning, Locator: "clusteroperator/dns", Message: "ignored, not an error"},
+		Condition{Level: Error, Locator: "pod/foo", Message: "ignored, not a cluster operator/version locator"},
+		Condition{Level: Error, Locator: "clusteroperator/dns", Message: "DNS default is degraded"},
+		Condition{Level: Error, Locator: "clusterversion/version", Message: "cluster is unreachable"},
+	)
+
+	if len(s.order) != 2 {
+		t.Fatalf("order = %v, want 2 locators", s.order)
+	}
+	if got := s.messages["clusteroperator/dns"]; len(got) != 1 || got[0] != "DNS default is degraded" {
+		t.Errorf("messages[clusteroperator/dns] = %v", got)
+	}
+}
+
+func TestJUnitSinkFlushEncodesTestsuite(t *testing.T) {
+	s := NewJUnitSink("e2e")
+	s.Record(
+		Condition{Level: Error, Locator: "clusteroperator/dns", Message: "first failure"},
+		Condition{Level: Error, Locator: "clusteroperator/dns", Message: "second failure"},
+	)
+
+	var buf bytes.Buffer
+	if err := s.Flush(&buf); err != nil {
+		t.Fatalf("Flush: %v", err)
+	}
+
+	var suite junitTestSuite
+	if err := xml.Unmarshal(buf.Bytes(), &suite); err != nil {
+		t.Fatalf("unmarshal: %v\noutput: %s", err, buf.String())
+	}
+	if suite.Name != "e2e" || suite.Tests != 1 || suite.Failures != 1 {
+		t.Errorf("suite = %+v, want Name=e2e Tests=1 Failures=1", suite)
+	}
+	if len(suite.Cases) != 1 || suite.Cases[0].Name != "clusteroperator/dns" {
+		t.Fatalf("cases = %+v", suite.Cases)
+	}
+	if suite.Cases[0].Failure == nil {
+		t.Fatalf("case has no failure")
+	}
+	if got := suite.Cases[0].Failure.Text; got != "first failure\nsecond failure" {
+		t.Errorf("failure text = %q", got)
+	}
+}
+
+func TestJUnitSinkFlushNoErrors(t *testing.T) {
+	s := NewJUnitSink("e2e")
+	var buf bytes.Buffer
+	if err := s.Flush(&buf); err != nil {
+		t.Fatalf("Flush: %v", err)
+	}
+
+	var suite junitTestSuite
+	if err := xml.Unmarshal(buf.Bytes(), &suite); err != nil {
+		t.Fatalf("unmarshal: %v", err)
+	}
+	if suite.Tests != 0 || suite.Failures != 0 || len(suite.Cases) != 0 {
+		t.Errorf("suite = %+v, want empty", suite)
+	}
+}